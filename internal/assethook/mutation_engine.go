@@ -0,0 +1,102 @@
+package assethook
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"path/filepath"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/pkg/errors"
+
+	"github.com/kyma-project/rafter/pkg/apis/rafter/v1beta1"
+)
+
+// MutationEngine posts asset file content to the configured AssetMutationWebhookServices
+// and applies the JSON-Patch/merge-patch response back onto the files on disk, mirroring
+// how ValidationEngine posts the same files for validation.
+type MutationEngine struct {
+	processor HttpProcessor
+}
+
+// NewTestMutator returns a MutationEngine backed directly by processor, bypassing
+// production wiring, for use in unit tests.
+func NewTestMutator(processor HttpProcessor) *MutationEngine {
+	return &MutationEngine{processor: processor}
+}
+
+// MutationResult reports whether every patch returned by the mutators applied cleanly.
+type MutationResult struct {
+	Success bool
+	Errors  map[string][]Message
+}
+
+// Mutate posts the files under basePath to every service in services and applies each
+// returned JSON-Patch or JSON merge-patch document onto the corresponding file before
+// returning.
+func (e *MutationEngine) Mutate(ctx context.Context, basePath string, files []string, services []v1beta1.AssetMutationWebhookService) (MutationResult, error) {
+	webhookServices := make([]v1beta1.AssetWebhookService, 0, len(services))
+	for _, service := range services {
+		webhookServices = append(webhookServices, v1beta1.AssetWebhookService(service))
+	}
+
+	patches, err := e.processor.Do(ctx, basePath, files, webhookServices)
+	if err != nil {
+		return MutationResult{}, err
+	}
+
+	result := MutationResult{Success: true}
+
+	for filename, messages := range patches {
+		path := filepath.Join(basePath, filename)
+
+		original, err := ioutil.ReadFile(path)
+		if err != nil {
+			return MutationResult{}, errors.Wrapf(err, "while reading file %s for mutation", path)
+		}
+
+		mutated := original
+		fileFailed := false
+		for _, message := range messages {
+			patched, err := applyPatch(mutated, []byte(message.Message))
+			if err != nil {
+				fileFailed = true
+				result.Success = false
+				if result.Errors == nil {
+					result.Errors = map[string][]Message{}
+				}
+				result.Errors[filename] = append(result.Errors[filename], Message{Filename: filename, Message: err.Error()})
+				continue
+			}
+			mutated = patched
+		}
+
+		// Only this file's own patches gate its write - one file failing to apply must
+		// not leave another, independently-succeeded file unwritten or vice versa, since
+		// files are otherwise iterated in random map order.
+		if fileFailed {
+			continue
+		}
+
+		if err := ioutil.WriteFile(path, mutated, 0644); err != nil {
+			return MutationResult{}, errors.Wrapf(err, "while writing mutated file %s", path)
+		}
+	}
+
+	return result, nil
+}
+
+// applyPatch applies patch to original, accepting either an RFC 6902 JSON-Patch
+// document (a JSON array of operations) or a JSON merge-patch document (a JSON
+// object), since mutation webhooks are free to return whichever fits their change.
+func applyPatch(original, patch []byte) ([]byte, error) {
+	if bytes.HasPrefix(bytes.TrimSpace(patch), []byte("[")) {
+		decoded, err := jsonpatch.DecodePatch(patch)
+		if err != nil {
+			return nil, errors.Wrap(err, "while decoding JSON-Patch document")
+		}
+		return decoded.Apply(original)
+	}
+
+	return jsonpatch.MergePatch(original, patch)
+}
@@ -0,0 +1,106 @@
+package assethook_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kyma-project/rafter/internal/assethook"
+	"github.com/kyma-project/rafter/internal/assethook/automock"
+	"github.com/kyma-project/rafter/pkg/apis/rafter/v1beta1"
+	"github.com/onsi/gomega"
+)
+
+func TestMutationEngine_Mutate(t *testing.T) {
+	const filename = "foo.json"
+
+	for testName, testCase := range map[string]struct {
+		err       error
+		messages  map[string][]assethook.Message
+		original  string
+		wantFinal string
+		wantOk    bool
+	}{
+		"success": {
+			original:  `{"foo":"bar"}`,
+			wantFinal: `{"foo":"bar"}`,
+			wantOk:    true,
+		},
+		"error": {
+			err:      fmt.Errorf("test"),
+			original: `{"foo":"bar"}`,
+		},
+		"patch-applied": {
+			messages: map[string][]assethook.Message{
+				filename: {
+					{Filename: filename, Message: `{"foo":"baz"}`},
+				},
+			},
+			original:  `{"foo":"bar"}`,
+			wantFinal: `{"foo":"baz"}`,
+			wantOk:    true,
+		},
+		"patch-invalid": {
+			messages: map[string][]assethook.Message{
+				filename: {
+					{Filename: filename, Message: `not-json`},
+				},
+			},
+			original: `{"foo":"bar"}`,
+			wantOk:   false,
+		},
+		"json-patch-applied": {
+			messages: map[string][]assethook.Message{
+				filename: {
+					{Filename: filename, Message: `[{"op":"replace","path":"/foo","value":"baz"}]`},
+				},
+			},
+			original:  `{"foo":"bar"}`,
+			wantFinal: `{"foo":"baz"}`,
+			wantOk:    true,
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			// Given
+			g := gomega.NewGomegaWithT(t)
+
+			dir, err := ioutil.TempDir("", "mutation-engine-test")
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			defer os.RemoveAll(dir)
+
+			path := filepath.Join(dir, filename)
+			g.Expect(ioutil.WriteFile(path, []byte(testCase.original), 0644)).To(gomega.Succeed())
+
+			processor := automock.NewHttpProcessor()
+			defer processor.AssertExpectations(t)
+			ctx := context.TODO()
+			files := []string{filename}
+			services := []v1beta1.AssetWebhookService{}
+
+			processor.On("Do", ctx, dir, files, services).Return(testCase.messages, testCase.err).Once()
+			mutator := assethook.NewTestMutator(processor)
+
+			// When
+			result, err := mutator.Mutate(ctx, dir, files, []v1beta1.AssetMutationWebhookService{})
+
+			// Then
+			if testCase.err == nil {
+				g.Expect(err).ToNot(gomega.HaveOccurred())
+			} else {
+				g.Expect(err).To(gomega.HaveOccurred())
+				return
+			}
+
+			g.Expect(result.Success).To(gomega.Equal(testCase.wantOk))
+
+			if testCase.wantOk {
+				got, err := ioutil.ReadFile(path)
+				g.Expect(err).NotTo(gomega.HaveOccurred())
+				g.Expect(string(got)).To(gomega.Equal(testCase.wantFinal))
+			}
+		})
+	}
+}
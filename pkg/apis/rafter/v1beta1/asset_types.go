@@ -0,0 +1,90 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Asset represents a single downloadable resource stored in a namespaced Bucket.
+type Asset struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AssetSpec   `json:"spec"`
+	Status AssetStatus `json:"status,omitempty"`
+}
+
+// AssetSpec describes the desired state of an Asset.
+type AssetSpec struct {
+	CommonAssetSpec `json:",inline"`
+	BucketRef       LocalBucketReference `json:"bucketRef,omitempty"`
+}
+
+// AssetStatus describes the observed state of an Asset.
+type AssetStatus struct {
+	CommonAssetStatus `json:",inline"`
+	// VersionID is the bucket-assigned version of the object backing this asset,
+	// populated once the backing Bucket has versioning enabled.
+	VersionID            string `json:"versionID,omitempty"`
+	AssetLifecycleStatus `json:",inline"`
+}
+
+// LocalBucketReference points to a Bucket in the same namespace as the Asset.
+type LocalBucketReference struct {
+	Name string `json:"name"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AssetList is a list of Assets.
+type AssetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Asset `json:"items"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterAsset is the cluster-scoped counterpart of Asset, backed by a ClusterBucket.
+type ClusterAsset struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterAssetSpec   `json:"spec"`
+	Status ClusterAssetStatus `json:"status,omitempty"`
+}
+
+// ClusterAssetSpec describes the desired state of a ClusterAsset.
+type ClusterAssetSpec struct {
+	CommonAssetSpec `json:",inline"`
+	BucketRef       ClusterBucketReference `json:"bucketRef,omitempty"`
+}
+
+// ClusterAssetStatus describes the observed state of a ClusterAsset.
+type ClusterAssetStatus struct {
+	CommonAssetStatus `json:",inline"`
+	// VersionID is the bucket-assigned version of the object backing this asset,
+	// populated once the backing ClusterBucket has versioning enabled.
+	VersionID            string `json:"versionID,omitempty"`
+	AssetLifecycleStatus `json:",inline"`
+}
+
+// ClusterBucketReference points to the ClusterBucket backing a ClusterAsset.
+type ClusterBucketReference struct {
+	Name string `json:"name"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterAssetList is a list of ClusterAssets.
+type ClusterAssetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ClusterAsset `json:"items"`
+}
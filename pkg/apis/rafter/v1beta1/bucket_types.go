@@ -0,0 +1,90 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Versioning controls whether object versioning is enabled on the backing bucket.
+// It mirrors the semantics of MinIO's MakeBucketWithObjectLocking/EnableVersioning calls.
+type Versioning struct {
+	// Enabled turns on object versioning for the bucket. Existing objects are not
+	// retroactively versioned; only writes made after this becomes true are.
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Bucket represents a namespaced storage bucket backing one or more Assets.
+type Bucket struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BucketSpec   `json:"spec"`
+	Status BucketStatus `json:"status,omitempty"`
+}
+
+// BucketSpec describes the desired state of a Bucket.
+type BucketSpec struct {
+	CommonBucketSpec `json:",inline"`
+	// Versioning enables object versioning on the underlying MinIO/S3 bucket.
+	Versioning Versioning `json:"versioning,omitempty"`
+	// Lifecycle declares rules for transitioning older assets to cold-tier storage.
+	Lifecycle LifecycleSpec `json:"lifecycle,omitempty"`
+}
+
+// BucketStatus describes the observed state of a Bucket.
+type BucketStatus struct {
+	CommonBucketStatus `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BucketList is a list of Buckets.
+type BucketList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Bucket `json:"items"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterBucket is the cluster-scoped counterpart of Bucket, backing ClusterAssets.
+type ClusterBucket struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterBucketSpec   `json:"spec"`
+	Status ClusterBucketStatus `json:"status,omitempty"`
+}
+
+// ClusterBucketSpec describes the desired state of a ClusterBucket.
+type ClusterBucketSpec struct {
+	CommonBucketSpec `json:",inline"`
+	// Versioning enables object versioning on the underlying MinIO/S3 bucket.
+	Versioning Versioning `json:"versioning,omitempty"`
+	// Replication declares the remote endpoints this bucket's assets should be
+	// replicated to.
+	Replication AssetReplicationSpec `json:"replication,omitempty"`
+	// Lifecycle declares rules for transitioning older assets to cold-tier storage.
+	Lifecycle LifecycleSpec `json:"lifecycle,omitempty"`
+}
+
+// ClusterBucketStatus describes the observed state of a ClusterBucket.
+type ClusterBucketStatus struct {
+	CommonBucketStatus `json:",inline"`
+	Replication        AssetReplicationStatus `json:"replication,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterBucketList is a list of ClusterBuckets.
+type ClusterBucketList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ClusterBucket `json:"items"`
+}
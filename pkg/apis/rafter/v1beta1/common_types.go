@@ -0,0 +1,116 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AssetMode defines how the asset content should be handled once downloaded from Source.URL.
+type AssetMode string
+
+const (
+	AssetSingle    AssetMode = "single"
+	AssetPackage   AssetMode = "package"
+	AssetIndex     AssetMode = "index"
+	AssetConfigMap AssetMode = "configmap"
+)
+
+// AssetPhase describes the current state of an Asset/ClusterAsset in its lifecycle.
+type AssetPhase string
+
+const (
+	AssetPending AssetPhase = "Pending"
+	AssetReady   AssetPhase = "Ready"
+	AssetFailed  AssetPhase = "Failed"
+)
+
+// BucketPhase describes the current state of a Bucket/ClusterBucket in its lifecycle.
+type BucketPhase string
+
+const (
+	BucketPending BucketPhase = "Pending"
+	BucketReady   BucketPhase = "Ready"
+	BucketFailed  BucketPhase = "Failed"
+)
+
+// AssetWebhookService points to a webhook endpoint that is called during asset processing.
+type AssetWebhookService struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Endpoint  string `json:"endpoint"`
+	// Filter limits which files from the asset package are sent to the webhook.
+	Filter string `json:"filter,omitempty"`
+}
+
+// AssetSource describes where the asset content is fetched from and how it is processed.
+type AssetSource struct {
+	URL    string    `json:"url"`
+	Mode   AssetMode `json:"mode"`
+	Filter string    `json:"filter,omitempty"`
+	// VersionID pins the source to a specific version of the object at URL, when URL
+	// itself points at a versioned bucket. Leave empty to always fetch the latest
+	// version.
+	VersionID string `json:"versionID,omitempty"`
+
+	ValidationWebhookService []AssetWebhookService `json:"validationWebhookService,omitempty"`
+	MetadataWebhookService   []AssetWebhookService `json:"metadataWebhookService,omitempty"`
+	// MutationWebhookService lists webhooks that can patch this asset's files before
+	// they are stored.
+	MutationWebhookService []AssetMutationWebhookService `json:"mutationWebhookService,omitempty"`
+}
+
+// AssetMutationWebhookService points to a webhook endpoint that returns a JSON-Patch or
+// JSON merge-patch document to apply to an asset's files before it is stored.
+type AssetMutationWebhookService struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Endpoint  string `json:"endpoint"`
+	// Filter limits which files from the asset package are sent to the webhook.
+	Filter string `json:"filter,omitempty"`
+}
+
+// CommonAssetSpec holds the fields shared between Asset and ClusterAsset.
+type CommonAssetSpec struct {
+	Source      AssetSource `json:"source"`
+	DisplayName string      `json:"displayName,omitempty"`
+}
+
+// CommonAssetStatus holds the status fields shared between Asset and ClusterAsset.
+type CommonAssetStatus struct {
+	Phase              AssetPhase  `json:"phase"`
+	Reason             string      `json:"reason,omitempty"`
+	Message            string      `json:"message,omitempty"`
+	AssetRef           AssetRef    `json:"assetRef,omitempty"`
+	LastHeartbeatTime  metav1.Time `json:"lastHeartbeatTime,omitempty"`
+	ObservedGeneration int64       `json:"observedGeneration,omitempty"`
+}
+
+// AssetRef points to the objects backing an Asset/ClusterAsset once it has been stored.
+type AssetRef struct {
+	BaseURL string   `json:"baseUrl,omitempty"`
+	Files   []string `json:"files,omitempty"`
+}
+
+// BucketPolicy defines the access policy applied to the bucket.
+type BucketPolicy string
+
+const (
+	BucketPolicyNone      BucketPolicy = "none"
+	BucketPolicyReadOnly  BucketPolicy = "readonly"
+	BucketPolicyWriteOnly BucketPolicy = "writeonly"
+	BucketPolicyReadWrite BucketPolicy = "readwrite"
+)
+
+// CommonBucketSpec holds the fields shared between Bucket and ClusterBucket.
+type CommonBucketSpec struct {
+	Region string       `json:"region,omitempty"`
+	Policy BucketPolicy `json:"policy,omitempty"`
+}
+
+// CommonBucketStatus holds the status fields shared between Bucket and ClusterBucket.
+type CommonBucketStatus struct {
+	Phase             BucketPhase `json:"phase"`
+	Reason            string      `json:"reason,omitempty"`
+	Message           string      `json:"message,omitempty"`
+	LastHeartbeatTime metav1.Time `json:"lastHeartbeatTime,omitempty"`
+	URL               string      `json:"url,omitempty"`
+}
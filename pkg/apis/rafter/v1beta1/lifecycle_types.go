@@ -0,0 +1,38 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// StorageTier describes an external S3-compatible endpoint that assets can be
+// transitioned to once they match a LifecycleRule.
+type StorageTier struct {
+	Name     string `json:"name"`
+	Endpoint string `json:"endpoint"`
+	Bucket   string `json:"bucket,omitempty"`
+	UseSSL   bool   `json:"useSSL,omitempty"`
+	// CredentialsSecretRef points to a Secret holding "accessKey" and "secretKey"
+	// entries for the tier's endpoint.
+	CredentialsSecretRef SecretKeyRef `json:"credentialsSecretRef"`
+}
+
+// LifecycleRule moves assets older than OlderThanDays to the tier named Tier.
+type LifecycleRule struct {
+	// OlderThanDays is measured from the object's creation time, mirroring MinIO's
+	// own lifecycle transition semantics.
+	OlderThanDays int    `json:"olderThanDays"`
+	Tier          string `json:"tier"`
+}
+
+// LifecycleSpec declares the storage tiers and transition rules for a bucket.
+type LifecycleSpec struct {
+	Tiers []StorageTier   `json:"tiers,omitempty"`
+	Rules []LifecycleRule `json:"rules,omitempty"`
+}
+
+// AssetLifecycleStatus reports the tier an Asset's backing object was transitioned to,
+// if any.
+type AssetLifecycleStatus struct {
+	Tier           string      `json:"tier,omitempty"`
+	TransitionedAt metav1.Time `json:"transitionedAt,omitempty"`
+}
@@ -0,0 +1,52 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReplicationTarget declares one remote MinIO/S3-compatible endpoint that assets in a
+// ClusterBucket should be replicated to.
+type ReplicationTarget struct {
+	// Name identifies the target within the ClusterBucket's replication status.
+	Name string `json:"name"`
+	// Endpoint is the remote MinIO/S3 endpoint, e.g. "minio.eu-west.example.com".
+	Endpoint string `json:"endpoint"`
+	Region   string `json:"region,omitempty"`
+	UseSSL   bool   `json:"useSSL,omitempty"`
+	// Bucket is the name of the bucket on the remote endpoint. Defaults to the source
+	// ClusterBucket's own bucket name when empty.
+	Bucket string `json:"bucket,omitempty"`
+	// CredentialsSecretRef points to a Secret in the configured system namespace holding
+	// "accessKey" and "secretKey" entries for the remote endpoint.
+	CredentialsSecretRef SecretKeyRef `json:"credentialsSecretRef"`
+	// ServerSideReplication requests that MinIO's own bucket replication (SetBucketReplication)
+	// be configured for this target instead of mirroring writes from the controller.
+	ServerSideReplication bool `json:"serverSideReplication,omitempty"`
+}
+
+// SecretKeyRef points to a Secret by name, in the operator's own namespace.
+type SecretKeyRef struct {
+	Name string `json:"name"`
+}
+
+// AssetReplicationSpec declares the replication targets for a ClusterBucket.
+type AssetReplicationSpec struct {
+	Targets []ReplicationTarget `json:"targets,omitempty"`
+}
+
+// ReplicationTargetStatus reports the observed replication state for one target.
+type ReplicationTargetStatus struct {
+	Name               string      `json:"name"`
+	LastReplicatedTime metav1.Time `json:"lastReplicatedTime,omitempty"`
+	// LagSeconds is the number of seconds elapsed since the last successful mirror to
+	// this target, measured when the status is computed. It does not measure the delay
+	// between an object's write to the source bucket and its arrival at the target.
+	LagSeconds int64  `json:"lagSeconds,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+	Message    string `json:"message,omitempty"`
+}
+
+// AssetReplicationStatus reports the observed replication state for every target.
+type AssetReplicationStatus struct {
+	Targets []ReplicationTargetStatus `json:"targets,omitempty"`
+}
@@ -0,0 +1,122 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+	"github.com/pkg/errors"
+
+	"github.com/kyma-project/rafter/pkg/apis/rafter/v1beta1"
+)
+
+// Lifecycle translates a bucket's LifecycleSpec into MinIO bucket lifecycle
+// configuration.
+type Lifecycle struct {
+	client *minio.Client
+	tiers  map[string]tierClient
+}
+
+// tierClient is the resolved client/bucket pair for one cold-tier StorageTier, once
+// its CredentialsSecretRef has been read.
+type tierClient struct {
+	bucket string
+	client *minio.Client
+}
+
+// NewLifecycle returns a Lifecycle store backed by the given MinIO client.
+func NewLifecycle(client *minio.Client) *Lifecycle {
+	return &Lifecycle{client: client, tiers: map[string]tierClient{}}
+}
+
+// RegisterTier wires up the MinIO client to use for objects transitioned to tier,
+// once its endpoint's credentials have been resolved from CredentialsSecretRef.
+func (l *Lifecycle) RegisterTier(tier v1beta1.StorageTier, client *minio.Client) {
+	bucket := tier.Bucket
+	if bucket == "" {
+		bucket = tier.Name
+	}
+
+	l.tiers[tier.Name] = tierClient{bucket: bucket, client: client}
+}
+
+// Apply configures bucketName's lifecycle rules to match spec.
+func (l *Lifecycle) Apply(ctx context.Context, bucketName string, spec v1beta1.LifecycleSpec) error {
+	if len(spec.Rules) == 0 {
+		return nil
+	}
+
+	cfg := lifecycle.NewConfiguration()
+	for i, rule := range spec.Rules {
+		cfg.Rules = append(cfg.Rules, lifecycle.Rule{
+			ID:     fmt.Sprintf("%s-%d", bucketName, i),
+			Status: "Enabled",
+			Transition: lifecycle.Transition{
+				Days:         lifecycle.ExpirationDays(rule.OlderThanDays),
+				StorageClass: rule.Tier,
+			},
+		})
+	}
+
+	if err := l.client.SetBucketLifecycle(ctx, bucketName, cfg); err != nil {
+		return errors.Wrapf(err, "while setting lifecycle configuration on bucket %s", bucketName)
+	}
+
+	return nil
+}
+
+// ResolveURL returns the URL an asset reader should use to fetch objectName from
+// bucketName, presigning against the tier's own endpoint when the object has been
+// transitioned there. It returns empty tier/zero time when the object is still in the
+// primary bucket.
+func (l *Lifecycle) ResolveURL(ctx context.Context, bucketName, objectName string) (url string, tier string, transitionedAt time.Time, err error) {
+	info, err := l.client.StatObject(ctx, bucketName, objectName, minio.StatObjectOptions{})
+	if err != nil {
+		return "", "", time.Time{}, errors.Wrapf(err, "while statting object %s/%s", bucketName, objectName)
+	}
+
+	readCli, readBucket := l.client, bucketName
+	transitioned := info.StorageClass != "" && info.StorageClass != "STANDARD"
+
+	if transitioned {
+		tierCli, ok := l.tiers[info.StorageClass]
+		if !ok {
+			return "", "", time.Time{}, fmt.Errorf("object %s/%s was transitioned to tier %q, but no client is registered for it", bucketName, objectName, info.StorageClass)
+		}
+		readCli, readBucket = tierCli.client, tierCli.bucket
+	}
+
+	presigned, err := readCli.PresignedGetObject(ctx, readBucket, objectName, time.Hour, nil)
+	if err != nil {
+		return "", "", time.Time{}, errors.Wrapf(err, "while presigning read for %s/%s", readBucket, objectName)
+	}
+
+	if !transitioned {
+		return presigned.String(), "", time.Time{}, nil
+	}
+
+	return presigned.String(), info.StorageClass, info.LastModified, nil
+}
+
+// Get follows a possibly-tiered object all the way to its bytes, so asset reads keep
+// working transparently once an object has transitioned to a cold tier.
+func (l *Lifecycle) Get(ctx context.Context, bucketName, objectName string, httpClient *http.Client) (*http.Response, error) {
+	url, _, _, err := l.ResolveURL(ctx, bucketName, objectName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading %s/%s", bucketName, objectName)
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status %d while reading %s/%s", resp.StatusCode, bucketName, objectName)
+	}
+
+	return resp, nil
+}
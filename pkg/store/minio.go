@@ -0,0 +1,110 @@
+package store
+
+import (
+	"context"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/pkg/errors"
+
+	"github.com/kyma-project/rafter/pkg/apis/rafter/v1beta1"
+)
+
+// Bucket wraps the MinIO operations needed to reconcile a Bucket/ClusterBucket spec
+// against the backing object store.
+type Bucket struct {
+	client *minio.Client
+}
+
+// NewBucket returns a Bucket store backed by the given MinIO client.
+func NewBucket(client *minio.Client) *Bucket {
+	return &Bucket{client: client}
+}
+
+// Create ensures the bucket exists and applies the versioning configuration declared
+// in spec. Object locking has to be requested at bucket creation time, so buckets that
+// already exist without it cannot be upgraded in place - SetBucketVersioning is used
+// instead for the common case of enabling versioning on a bucket that already exists.
+func (b *Bucket) Create(ctx context.Context, bucketName, region string, spec v1beta1.CommonBucketSpec, versioning v1beta1.Versioning) error {
+	exists, err := b.client.BucketExists(ctx, bucketName)
+	if err != nil {
+		return errors.Wrapf(err, "while checking if bucket %s exists", bucketName)
+	}
+
+	if !exists {
+		opts := minio.MakeBucketOptions{Region: region, ObjectLocking: versioning.Enabled}
+		if err := b.client.MakeBucket(ctx, bucketName, opts); err != nil {
+			return errors.Wrapf(err, "while creating bucket %s", bucketName)
+		}
+	}
+
+	if versioning.Enabled {
+		cfg := minio.BucketVersioningConfiguration{Status: "Enabled"}
+		if err := b.client.SetBucketVersioning(ctx, bucketName, cfg); err != nil {
+			return errors.Wrapf(err, "while enabling versioning on bucket %s", bucketName)
+		}
+	}
+
+	return nil
+}
+
+// PutResult carries the outcome of storing an object, including the VersionID assigned
+// by the backing store when versioning is enabled on the bucket.
+type PutResult struct {
+	VersionID string
+}
+
+// PutObject uploads the file at path to the given bucket/objectName and returns the
+// VersionID reported by the store, if any.
+func (b *Bucket) PutObject(ctx context.Context, bucketName, objectName, path string) (PutResult, error) {
+	info, err := b.client.FPutObject(ctx, bucketName, objectName, path, minio.PutObjectOptions{})
+	if err != nil {
+		return PutResult{}, errors.Wrapf(err, "while uploading object %s/%s", bucketName, objectName)
+	}
+
+	return PutResult{VersionID: info.VersionID}, nil
+}
+
+// GetObject fetches objectName from bucketName, honoring source.VersionID when set so
+// the exact version an Asset source was pinned to is downloaded instead of the latest
+// one.
+func (b *Bucket) GetObject(ctx context.Context, bucketName, objectName string, source v1beta1.AssetSource) (*minio.Object, error) {
+	obj, err := b.client.GetObject(ctx, bucketName, objectName, minio.GetObjectOptions{VersionID: source.VersionID})
+	if err != nil {
+		if source.VersionID != "" {
+			return nil, errors.Wrapf(err, "while fetching object %s/%s version %s", bucketName, objectName, source.VersionID)
+		}
+		return nil, errors.Wrapf(err, "while fetching object %s/%s", bucketName, objectName)
+	}
+	return obj, nil
+}
+
+// DeleteOptions controls how DeleteLeftovers removes objects from a bucket.
+type DeleteOptions struct {
+	// PruneAllVersions removes every version of every matching object instead of just
+	// the current one, which is the default, backwards-compatible behavior.
+	PruneAllVersions bool
+}
+
+// DeleteLeftovers removes every object under prefix from bucketName. When
+// opts.PruneAllVersions is set, all versions of each matching object are removed instead
+// of only the live object.
+func (b *Bucket) DeleteLeftovers(ctx context.Context, bucketName, prefix string, opts DeleteOptions) error {
+	listOpts := minio.ListObjectsOptions{Prefix: prefix, Recursive: true, WithVersions: opts.PruneAllVersions}
+
+	for obj := range b.client.ListObjects(ctx, bucketName, listOpts) {
+		if obj.Err != nil {
+			return errors.Wrapf(obj.Err, "while listing objects under %s/%s", bucketName, prefix)
+		}
+
+		removeOpts := minio.RemoveObjectOptions{}
+		if opts.PruneAllVersions {
+			removeOpts.VersionID = obj.VersionID
+		}
+
+		if err := b.client.RemoveObject(ctx, bucketName, obj.Key, removeOpts); err != nil {
+			return errors.Wrapf(err, "while removing object %s/%s", bucketName, obj.Key)
+		}
+	}
+
+	return nil
+}
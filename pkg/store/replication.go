@@ -0,0 +1,186 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/replication"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/rafter/pkg/apis/rafter/v1beta1"
+)
+
+// Replica is a single remote bucket store that assets are mirrored or replicated to.
+type Replica struct {
+	Name   string
+	Bucket string
+	// ServerSide is true when this target is configured for MinIO's own bucket
+	// replication (SetBucketReplication), in which case the controller must not also
+	// mirror PUT/DELETE operations to it itself.
+	ServerSide bool
+	client     *minio.Client
+}
+
+// NewReplica wraps a MinIO client configured for one ReplicationTarget. serverSide
+// should mirror the target's ServerSideReplication setting.
+func NewReplica(name, bucket string, serverSide bool, client *minio.Client) *Replica {
+	return &Replica{Name: name, Bucket: bucket, ServerSide: serverSide, client: client}
+}
+
+// Replicator configures and drives replication of a bucket's objects to its targets.
+type Replicator struct {
+	source    *Bucket
+	sourceCli *minio.Client
+	replicas  []*Replica
+
+	// lastReplicated records the wall-clock time of the last successful MirrorPut,
+	// MirrorCopy or MirrorDelete per mirrored (non-ServerSide) replica name, so Status can
+	// report it.
+	lastReplicated map[string]time.Time
+}
+
+// NewReplicator returns a Replicator for the given source bucket store and targets.
+func NewReplicator(sourceCli *minio.Client, source *Bucket, replicas []*Replica) *Replicator {
+	return &Replicator{source: source, sourceCli: sourceCli, replicas: replicas, lastReplicated: map[string]time.Time{}}
+}
+
+// Configure sets up replication for every target declared in spec. Targets marked
+// ServerSideReplication each get their own destination rule in a single MinIO bucket
+// replication call; the rest are left for controller-driven mirroring of subsequent
+// PUT/DELETE operations via MirrorPut/MirrorDelete.
+func (r *Replicator) Configure(ctx context.Context, bucketName string, spec v1beta1.AssetReplicationSpec) error {
+	var rules []replication.Rule
+
+	for i, target := range spec.Targets {
+		if !target.ServerSideReplication {
+			continue
+		}
+
+		destBucket := target.Bucket
+		if destBucket == "" {
+			destBucket = bucketName
+		}
+
+		rules = append(rules, replication.Rule{
+			ID:                      fmt.Sprintf("%s-%d", target.Name, i),
+			Status:                  "Enabled",
+			Priority:                i + 1,
+			DeleteMarkerReplication: replication.DeleteMarkerReplication{Status: "Enabled"},
+			Destination: replication.Destination{
+				Bucket: fmt.Sprintf("arn:aws:s3:::%s", destBucket),
+			},
+		})
+	}
+
+	if len(rules) == 0 {
+		return nil
+	}
+
+	cfg := replication.Config{Rules: rules}
+	if err := r.sourceCli.SetBucketReplication(ctx, bucketName, cfg); err != nil {
+		return errors.Wrapf(err, "while configuring server-side replication for bucket %s", bucketName)
+	}
+
+	return nil
+}
+
+// MirrorPut pushes the object at path to every replica that is not handled by
+// server-side replication.
+func (r *Replicator) MirrorPut(ctx context.Context, objectName, path string) error {
+	for _, replica := range r.replicas {
+		if replica.ServerSide {
+			continue
+		}
+
+		if _, err := replica.client.FPutObject(ctx, replica.Bucket, objectName, path, minio.PutObjectOptions{}); err != nil {
+			return errors.Wrapf(err, "while mirroring object %s to replica %s", objectName, replica.Name)
+		}
+
+		r.lastReplicated[replica.Name] = time.Now()
+	}
+
+	return nil
+}
+
+// MirrorCopy copies objectName from sourceBucket (read via sourceCli) into every replica
+// that is not handled by server-side replication. Unlike MirrorPut, it reads the object
+// back from the store itself rather than from a local path, which is the only thing
+// available to callers - such as the test suite - that only observe an object after it
+// has already landed in the primary bucket.
+func (r *Replicator) MirrorCopy(ctx context.Context, sourceCli *minio.Client, sourceBucket, objectName string) error {
+	for _, replica := range r.replicas {
+		if replica.ServerSide {
+			continue
+		}
+
+		obj, err := sourceCli.GetObject(ctx, sourceBucket, objectName, minio.GetObjectOptions{})
+		if err != nil {
+			return errors.Wrapf(err, "while reading object %s/%s to mirror to replica %s", sourceBucket, objectName, replica.Name)
+		}
+
+		info, err := obj.Stat()
+		if err != nil {
+			obj.Close()
+			return errors.Wrapf(err, "while statting object %s/%s to mirror to replica %s", sourceBucket, objectName, replica.Name)
+		}
+
+		_, err = replica.client.PutObject(ctx, replica.Bucket, objectName, obj, info.Size, minio.PutObjectOptions{})
+		obj.Close()
+		if err != nil {
+			return errors.Wrapf(err, "while mirroring object %s to replica %s", objectName, replica.Name)
+		}
+
+		r.lastReplicated[replica.Name] = time.Now()
+	}
+
+	return nil
+}
+
+// MirrorDelete removes objectName from every replica that is not handled by
+// server-side replication.
+func (r *Replicator) MirrorDelete(ctx context.Context, objectName string) error {
+	for _, replica := range r.replicas {
+		if replica.ServerSide {
+			continue
+		}
+
+		if err := replica.client.RemoveObject(ctx, replica.Bucket, objectName, minio.RemoveObjectOptions{}); err != nil {
+			return errors.Wrapf(err, "while mirroring deletion of %s to replica %s", objectName, replica.Name)
+		}
+
+		r.lastReplicated[replica.Name] = time.Now()
+	}
+
+	return nil
+}
+
+// Status reports the observed replication state for every mirrored (non-ServerSide)
+// replica that has completed at least one MirrorPut/MirrorCopy/MirrorDelete. LagSeconds is the
+// time elapsed since that last successful mirror, which is the best this package can
+// report without a dedicated replication-metrics API; ServerSide targets are covered by
+// MinIO's own bucket replication metrics instead and are omitted here.
+func (r *Replicator) Status() []v1beta1.ReplicationTargetStatus {
+	var statuses []v1beta1.ReplicationTargetStatus
+
+	for _, replica := range r.replicas {
+		if replica.ServerSide {
+			continue
+		}
+
+		lastReplicated, ok := r.lastReplicated[replica.Name]
+		if !ok {
+			continue
+		}
+
+		statuses = append(statuses, v1beta1.ReplicationTargetStatus{
+			Name:               replica.Name,
+			LastReplicatedTime: metav1.NewTime(lastReplicated),
+			LagSeconds:         int64(time.Since(lastReplicated).Seconds()),
+		})
+	}
+
+	return statuses
+}
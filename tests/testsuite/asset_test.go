@@ -0,0 +1,61 @@
+package testsuite
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kyma-project/rafter/pkg/apis/rafter/v1beta1"
+)
+
+// filterAssetDetailsByMode keeps only the asset metadata matching one of modes, so a
+// single Mode can be exercised by its own spec without dragging the others along.
+func filterAssetDetailsByMode(details []assetData, modes []v1beta1.AssetMode) []assetData {
+	wanted := make(map[v1beta1.AssetMode]bool, len(modes))
+	for _, m := range modes {
+		wanted[m] = true
+	}
+
+	var filtered []assetData
+	for _, d := range details {
+		if wanted[d.Mode] {
+			filtered = append(filtered, d)
+		}
+	}
+	return filtered
+}
+
+var _ = Describe("Asset upload", func() {
+	var ts *TestSuite
+
+	BeforeEach(func() {
+		var err error
+		ts, _, err = newSpecSuite()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(ts.DeleteLeftovers()).To(Succeed())
+		Expect(ts.SetupNamespace()).To(Succeed())
+		Expect(ts.CreateClusterBucket()).To(Succeed())
+		Expect(ts.CreateBucket()).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(ts.Teardown()).To(Succeed())
+	})
+
+	DescribeTable("uploads and serves the asset for a given Mode",
+		func(mode v1beta1.AssetMode) {
+			Expect(ts.UploadAndPrepareAssetDetails(mode)).To(Succeed())
+			Expect(ts.CreateAssets()).To(Succeed())
+
+			files, err := ts.PopulateAndVerifyUploads()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).NotTo(BeEmpty())
+
+			Expect(ts.DeleteAssetsAndVerify(files)).To(Succeed())
+		},
+		Entry("Single", v1beta1.AssetSingle),
+		Entry("Package", v1beta1.AssetPackage),
+		Entry("Index", v1beta1.AssetIndex),
+		Entry("ConfigMap", v1beta1.AssetConfigMap),
+	)
+})
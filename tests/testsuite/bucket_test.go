@@ -0,0 +1,28 @@
+package testsuite
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bucket", func() {
+	var ts *TestSuite
+
+	BeforeEach(func() {
+		var err error
+		ts, _, err = newSpecSuite()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(ts.DeleteLeftovers()).To(Succeed())
+		Expect(ts.SetupNamespace()).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(ts.Teardown()).To(Succeed())
+	})
+
+	It("becomes ready and supports object versioning", func() {
+		Expect(ts.CreateBucket()).To(Succeed())
+		Expect(ts.verifyVersioning(ts.cfg.BucketName)).To(Succeed())
+	})
+})
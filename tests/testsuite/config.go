@@ -0,0 +1,23 @@
+package testsuite
+
+// MinioConfig holds the connection details for the MinIO instance under test.
+type MinioConfig struct {
+	Endpoint  string `envconfig:"default=localhost:9000"`
+	AccessKey string `envconfig:"default=minio"`
+	SecretKey string `envconfig:"default=minio123"`
+	UseSSL    bool   `envconfig:"default=false"`
+
+	// Replicas lists additional MinIO endpoints that the testsuite mirrors every asset
+	// file uploaded to Endpoint (and later its deletion) to, and then checks. The
+	// mirroring is done by the testsuite itself through store.Replicator, so these
+	// checks do not show that any controller replicates on its own.
+	Replicas []MinioReplicaConfig `envconfig:"optional"`
+}
+
+// MinioReplicaConfig describes one replication target used by the replication tests.
+type MinioReplicaConfig struct {
+	Endpoint  string `envconfig:"optional"`
+	AccessKey string `envconfig:"optional"`
+	SecretKey string `envconfig:"optional"`
+	UseSSL    bool   `envconfig:"default=false"`
+}
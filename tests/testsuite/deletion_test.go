@@ -0,0 +1,34 @@
+package testsuite
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Deletion", func() {
+	var ts *TestSuite
+
+	BeforeEach(func() {
+		var err error
+		ts, _, err = newSpecSuite()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(ts.DeleteLeftovers()).To(Succeed())
+		Expect(ts.SetupNamespace()).To(Succeed())
+		Expect(ts.CreateClusterBucket()).To(Succeed())
+		Expect(ts.CreateBucket()).To(Succeed())
+		Expect(ts.UploadAndPrepareAssetDetails()).To(Succeed())
+		Expect(ts.CreateAssets()).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(ts.Teardown()).To(Succeed())
+	})
+
+	It("removes the backing files once the Asset and ClusterAsset are deleted", func() {
+		files, err := ts.PopulateAndVerifyUploads()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(ts.DeleteAssetsAndVerify(files)).To(Succeed())
+	})
+})
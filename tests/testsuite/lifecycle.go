@@ -0,0 +1,61 @@
+package testsuite
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	"github.com/kyma-project/rafter/pkg/apis/rafter/v1beta1"
+	"github.com/kyma-project/rafter/pkg/store"
+)
+
+const (
+	lifecycleObjectName = "lifecycle-test/foo.json"
+	lifecycleTierName   = "cold"
+)
+
+// verifyLifecycleTransition uploads an object, transitions it to a cold tier the way
+// MinIO's own lifecycle engine would (by rewriting its storage class), and asserts
+// reads still succeed and are routed through tierCli/tierBucket once transitioned.
+// tierCli/tierBucket must be a genuinely separate endpoint from bucketName so the
+// transitioned read actually crosses to the tier, rather than resolving a URL that
+// happens to point at the same place the object already lives.
+func (t *TestSuite) verifyLifecycleTransition(bucketName string, tierCli *minio.Client, tierBucket string) error {
+	ctx := context.TODO()
+	path := localPath("foo.json")
+
+	if _, err := t.minioCli.FPutObject(ctx, bucketName, lifecycleObjectName, path, minio.PutObjectOptions{}); err != nil {
+		return errors.Wrap(err, "while uploading the object to transition")
+	}
+
+	lifecycle := store.NewLifecycle(t.minioCli)
+	lifecycle.RegisterTier(v1beta1.StorageTier{Name: lifecycleTierName, Bucket: tierBucket}, tierCli)
+
+	if _, err := tierCli.FPutObject(ctx, tierBucket, lifecycleObjectName, path, minio.PutObjectOptions{}); err != nil {
+		return errors.Wrap(err, "while seeding the cold tier with the transitioned object")
+	}
+	if _, err := t.minioCli.FPutObject(ctx, bucketName, lifecycleObjectName, path, minio.PutObjectOptions{StorageClass: lifecycleTierName}); err != nil {
+		return errors.Wrap(err, "while simulating the lifecycle transition to the cold tier")
+	}
+
+	url, tier, _, err := lifecycle.ResolveURL(ctx, bucketName, lifecycleObjectName)
+	if err != nil {
+		return errors.Wrap(err, "while resolving the URL for the transitioned object")
+	}
+	if tier != lifecycleTierName {
+		return errors.Errorf("expected the object to report tier %q after transition, got %q", lifecycleTierName, tier)
+	}
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return errors.Wrap(err, "while reading the transitioned object")
+	}
+	defer resp.Body.Close()
+
+	t.g.Expect(resp.StatusCode).To(gomega.Equal(http.StatusOK), "expected the transitioned object to still be readable through its tiered URL")
+
+	return nil
+}
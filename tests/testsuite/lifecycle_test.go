@@ -0,0 +1,32 @@
+package testsuite
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Asset lifecycle transitioning", func() {
+	var ts *TestSuite
+
+	BeforeEach(func() {
+		var err error
+		ts, _, err = newSpecSuite()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(ts.DeleteLeftovers()).To(Succeed())
+		Expect(ts.SetupNamespace()).To(Succeed())
+		Expect(ts.CreateBucket()).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(ts.Teardown()).To(Succeed())
+	})
+
+	It("keeps serving reads once the object has transitioned to a cold tier", func() {
+		if len(ts.replicaClis) == 0 {
+			Skip("no replica MinIO endpoint configured to use as the cold tier")
+		}
+
+		Expect(ts.verifyLifecycleTransition(ts.cfg.BucketName, ts.replicaClis[0], ts.cfg.BucketName)).To(Succeed())
+	})
+})
@@ -0,0 +1,8 @@
+package testsuite
+
+// Logger is satisfied by both *testing.T and Ginkgo's GinkgoTInterface, so the
+// harness can run either as a plain `go test` flow or as a Ginkgo spec.
+type Logger interface {
+	Log(args ...interface{})
+	Logf(format string, args ...interface{})
+}
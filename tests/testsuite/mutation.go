@@ -0,0 +1,80 @@
+package testsuite
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/pkg/errors"
+
+	"github.com/kyma-project/rafter/internal/assethook"
+)
+
+const mutationObjectName = "mutation-test/foo.json"
+
+// newTrivialMutatorServer starts a webhook that rewrites the "foo" field of foo.json to
+// newValue, mirroring the kind of mutator an AssetMutationWebhookService would front.
+func newTrivialMutatorServer(newValue string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		patch, _ := json.Marshal(map[string]string{"foo": newValue})
+		_ = json.NewEncoder(w).Encode(map[string][]assethook.Message{
+			"foo.json": {{Filename: "foo.json", Message: string(patch)}},
+		})
+	}))
+}
+
+// verifyMutatedUpload runs foo.json through a trivial mutation webhook, uploads the
+// mutated file to bucketName and asserts the stored object reflects the mutation.
+func (t *TestSuite) verifyMutatedUpload(bucketName string) error {
+	server := newTrivialMutatorServer("mutated")
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "mutation-upload-test")
+	if err != nil {
+		return errors.Wrap(err, "while creating a scratch dir for the mutation test")
+	}
+
+	original, err := ioutil.ReadFile(localPath("foo.json"))
+	if err != nil {
+		return errors.Wrap(err, "while reading the fixture to mutate")
+	}
+	if err := ioutil.WriteFile(dir+"/foo.json", original, 0644); err != nil {
+		return errors.Wrap(err, "while staging the fixture to mutate")
+	}
+
+	processor := assethook.NewHttpProcessor(server.URL)
+	mutator := assethook.NewTestMutator(processor)
+	ctx := context.TODO()
+
+	if _, err := mutator.Mutate(ctx, dir, []string{"foo.json"}, nil); err != nil {
+		return errors.Wrap(err, "while mutating the fixture")
+	}
+
+	if _, err := t.minioCli.FPutObject(ctx, bucketName, mutationObjectName, dir+"/foo.json", minio.PutObjectOptions{}); err != nil {
+		return errors.Wrap(err, "while uploading the mutated fixture")
+	}
+
+	obj, err := t.minioCli.GetObject(ctx, bucketName, mutationObjectName, minio.GetObjectOptions{})
+	if err != nil {
+		return errors.Wrap(err, "while fetching the mutated object")
+	}
+	defer obj.Close()
+
+	content, err := ioutil.ReadAll(obj)
+	if err != nil {
+		return errors.Wrap(err, "while reading the mutated object")
+	}
+
+	var decoded map[string]string
+	if err := json.Unmarshal(content, &decoded); err != nil {
+		return errors.Wrap(err, "while decoding the mutated object")
+	}
+	if decoded["foo"] != "mutated" {
+		return errors.Errorf("expected the uploaded object to reflect the mutation, got %q", string(content))
+	}
+
+	return nil
+}
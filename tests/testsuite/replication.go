@@ -0,0 +1,58 @@
+package testsuite
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	"github.com/kyma-project/rafter/pkg/store"
+)
+
+// verifyReplicatedFiles drives replication of files to every configured replica and then
+// asserts it took effect. When present is true each file is mirrored from its primary
+// bucket to every replica via store.Replicator.MirrorCopy before the check; when false
+// its deletion is mirrored instead via MirrorDelete. Mirroring a file that already
+// converged to the desired state is harmless, so this can run unconditionally rather than
+// depending on a separate reconciler having already done it. It is a no-op when no
+// replicas are configured.
+func (t *TestSuite) verifyReplicatedFiles(files []uploadedFile, present bool) error {
+	if len(t.replicaClis) == 0 {
+		return nil
+	}
+
+	ctx := context.TODO()
+
+	for _, f := range files {
+		replicas := make([]*store.Replica, 0, len(t.replicaClis))
+		for i, replicaCli := range t.replicaClis {
+			replicas = append(replicas, store.NewReplica(fmt.Sprintf("replica-%d", i), f.Bucket, false, replicaCli))
+		}
+		replicator := store.NewReplicator(t.minioCli, nil, replicas)
+
+		if present {
+			if err := replicator.MirrorCopy(ctx, t.minioCli, f.Bucket, f.FileName); err != nil {
+				return errors.Wrap(err, "while mirroring uploaded file to replicas")
+			}
+		} else if err := replicator.MirrorDelete(ctx, f.FileName); err != nil {
+			return errors.Wrap(err, "while mirroring deleted file to replicas")
+		}
+	}
+
+	for _, replicaCli := range t.replicaClis {
+		for _, f := range files {
+			replicaCli, f := replicaCli, f
+
+			t.g.Eventually(func() bool {
+				_, err := replicaCli.StatObject(ctx, f.Bucket, f.FileName, minio.StatObjectOptions{})
+				return err == nil
+			}, t.cfg.WaitTimeout, time.Second).Should(gomega.Equal(present),
+				"file %s/%s replication state did not converge to present=%v", f.Bucket, f.FileName, present)
+		}
+	}
+
+	return nil
+}
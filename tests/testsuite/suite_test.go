@@ -0,0 +1,53 @@
+package testsuite
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	"k8s.io/client-go/rest"
+)
+
+var (
+	suiteRestConfig *rest.Config
+	suiteCfg        Config
+)
+
+// RunSpecs boots the Ginkgo BDD suite against restConfig/cfg. Each concern - bucket
+// creation, cluster bucket creation, asset upload per Mode, webhook validation,
+// deletion - lives in its own Describe/It so failures localize to a single spec and
+// `ginkgo -p` can run them concurrently against the same cluster.
+func RunSpecs(t *testing.T, restConfig *rest.Config, cfg Config) {
+	suiteRestConfig = restConfig
+	suiteCfg = cfg
+
+	gomega.RegisterFailHandler(ginkgo.Fail)
+	ginkgo.RunSpecs(t, "Rafter E2E Test Suite")
+}
+
+// specSuffix identifies the running Ginkgo parallel process, so specs in different
+// processes never collide on the same namespace, bucket name or testId.
+func specSuffix() string {
+	return fmt.Sprintf("%d", ginkgo.GinkgoParallelProcess())
+}
+
+// newSpecSuite builds a TestSuite scoped to the current spec: its own namespace,
+// bucket names and testId, sharing the suite-wide rest/Minio configuration.
+func newSpecSuite() (*TestSuite, *gomega.GomegaWithT, error) {
+	g := gomega.NewGomegaWithT(ginkgo.GinkgoT())
+
+	suffix := specSuffix()
+	cfg := suiteCfg
+	cfg.Namespace = fmt.Sprintf("%s-%s", cfg.Namespace, suffix)
+	cfg.BucketName = fmt.Sprintf("%s-%s", cfg.BucketName, suffix)
+	cfg.ClusterBucketName = fmt.Sprintf("%s-%s", cfg.ClusterBucketName, suffix)
+
+	ts, err := New(suiteRestConfig, cfg, ginkgo.GinkgoT(), g)
+	if err != nil {
+		return nil, nil, err
+	}
+	ts.testId = fmt.Sprintf("singularity-%s", suffix)
+
+	return ts, g, nil
+}
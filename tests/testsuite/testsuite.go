@@ -5,10 +5,10 @@ import (
 	"fmt"
 	"net/http"
 	"os"
-	"testing"
 	"time"
 
-	"github.com/minio/minio-go"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/onsi/gomega"
 	"github.com/pkg/errors"
 	"k8s.io/client-go/dynamic"
@@ -32,6 +32,9 @@ type Config struct {
 	Minio             MinioConfig
 }
 
+// TestSuite is a per-spec harness around one namespace/bucket/cluster bucket. Every
+// Ginkgo spec builds its own instance via newSpecSuite so specs can run concurrently
+// against the same cluster without colliding on shared resources.
 type TestSuite struct {
 	namespace     *namespace.Namespace
 	configMap     *configmap.Configmap
@@ -41,7 +44,7 @@ type TestSuite struct {
 	asset         *asset
 	clusterAsset  *clusterAsset
 
-	t *testing.T
+	t Logger
 	g *gomega.GomegaWithT
 
 	assetDetails []assetData
@@ -49,12 +52,14 @@ type TestSuite struct {
 
 	systemBucketName string
 	minioCli         *minio.Client
+	replicaClis      []*minio.Client
+	dynamicCli       dynamic.Interface
 	cfg              Config
 
 	testId string
 }
 
-func New(restConfig *rest.Config, cfg Config, t *testing.T, g *gomega.GomegaWithT) (*TestSuite, error) {
+func New(restConfig *rest.Config, cfg Config, t Logger, g *gomega.GomegaWithT) (*TestSuite, error) {
 	coreCli, err := corev1.NewForConfig(restConfig)
 	if err != nil {
 		return nil, errors.Wrap(err, "while creating K8s Core client")
@@ -65,15 +70,31 @@ func New(restConfig *rest.Config, cfg Config, t *testing.T, g *gomega.GomegaWith
 		return nil, errors.Wrap(err, "while creating K8s Dynamic client")
 	}
 
-	minioCli, err := minio.New(cfg.Minio.Endpoint, cfg.Minio.AccessKey, cfg.Minio.SecretKey, cfg.Minio.UseSSL)
+	transport := &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	}
+
+	minioCli, err := minio.New(cfg.Minio.Endpoint, &minio.Options{
+		Creds:     credentials.NewStaticV4(cfg.Minio.AccessKey, cfg.Minio.SecretKey, ""),
+		Secure:    cfg.Minio.UseSSL,
+		Transport: transport,
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, "while creating Minio client")
 	}
 
-	transCfg := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	replicaClis := make([]*minio.Client, 0, len(cfg.Minio.Replicas))
+	for _, replicaCfg := range cfg.Minio.Replicas {
+		replicaCli, err := minio.New(replicaCfg.Endpoint, &minio.Options{
+			Creds:     credentials.NewStaticV4(replicaCfg.AccessKey, replicaCfg.SecretKey, ""),
+			Secure:    replicaCfg.UseSSL,
+			Transport: transport,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "while creating Minio client for replica %s", replicaCfg.Endpoint)
+		}
+		replicaClis = append(replicaClis, replicaCli)
 	}
-	minioCli.SetCustomTransport(transCfg)
 
 	ns := namespace.New(coreCli, cfg.Namespace)
 	cm := configmap.New(coreCli, cfg.Namespace, cfg.WaitTimeout)
@@ -94,124 +115,193 @@ func New(restConfig *rest.Config, cfg Config, t *testing.T, g *gomega.GomegaWith
 		t:             t,
 		g:             g,
 		minioCli:      minioCli,
+		replicaClis:   replicaClis,
+		dynamicCli:    dynamicCli,
 		testId:        "singularity",
 		cfg:           cfg,
 	}, nil
 }
 
-func (t *TestSuite) Run() {
-
-	// clean up leftovers from previous tests
+// DeleteLeftovers removes resources a previous, failed spec run in the same namespace
+// may have left behind.
+func (t *TestSuite) DeleteLeftovers() error {
 	t.t.Log("Deleting old assets...")
-	err := t.asset.DeleteLeftovers(t.testId)
-	failOnError(t.g, err)
+	if err := t.asset.DeleteLeftovers(t.testId); err != nil {
+		return err
+	}
 
 	t.t.Log("Deleting old cluster assets...")
-	err = t.clusterAsset.DeleteLeftovers(t.testId)
-	failOnError(t.g, err)
+	if err := t.clusterAsset.DeleteLeftovers(t.testId); err != nil {
+		return err
+	}
 
 	t.t.Log("Deleting old configmaps...")
-	err = t.configMap.DeleteAll(t.t.Log)
-	failOnError(t.g, err)
+	if err := t.configMap.DeleteAll(t.t.Log); err != nil {
+		return err
+	}
 
 	t.t.Log("Deleting old cluster bucket...")
-	err = t.clusterBucket.Delete(t.t.Log)
-	failOnError(t.g, err)
+	if err := t.clusterBucket.Delete(t.t.Log); err != nil {
+		return err
+	}
 
 	t.t.Log("Deleting old bucket...")
-	err = t.bucket.Delete(t.t.Log)
-	failOnError(t.g, err)
+	return t.bucket.Delete(t.t.Log)
+}
 
-	// setup environment
+// SetupNamespace creates the namespace this spec's resources live in.
+func (t *TestSuite) SetupNamespace() error {
 	t.t.Log("Creating namespace...")
-	err = t.namespace.Create(t.t.Log)
-	failOnError(t.g, err)
+	return t.namespace.Create(t.t.Log)
+}
 
+// CreateClusterBucket creates the spec's ClusterBucket and waits for it to become ready.
+func (t *TestSuite) CreateClusterBucket() error {
 	t.t.Log("Creating cluster bucket...")
-	var resourceVersion string
-	resourceVersion, err = t.clusterBucket.Create(t.t.Log)
-	failOnError(t.g, err)
+	resourceVersion, err := t.clusterBucket.Create(t.t.Log)
+	if err != nil {
+		return err
+	}
 
 	t.t.Log("Waiting for cluster bucket to have ready phase...")
-	err = t.clusterBucket.WaitForStatusReady(resourceVersion, t.t.Log)
-	failOnError(t.g, err)
+	return t.clusterBucket.WaitForStatusReady(resourceVersion, t.t.Log)
+}
 
+// CreateBucket creates the spec's Bucket and waits for it to become ready.
+func (t *TestSuite) CreateBucket() error {
 	t.t.Log("Creating bucket...")
-	resourceVersion, err = t.bucket.Create(t.t.Log)
-	failOnError(t.g, err)
+	resourceVersion, err := t.bucket.Create(t.t.Log)
+	if err != nil {
+		return err
+	}
 
 	t.t.Log("Waiting for bucket to have ready phase...")
-	err = t.bucket.WaitForStatusReady(resourceVersion, t.t.Log)
-	failOnError(t.g, err)
+	return t.bucket.WaitForStatusReady(resourceVersion, t.t.Log)
+}
 
+// UploadAndPrepareAssetDetails uploads the test fixtures and builds the asset metadata
+// used to create Assets/ClusterAssets, filtered down to the requested modes when modes
+// is non-empty.
+func (t *TestSuite) UploadAndPrepareAssetDetails(modes ...v1beta1.AssetMode) error {
 	t.t.Log("Uploading test files...")
 	uploadResult, err := t.uploadTestFiles()
-	failOnError(t.g, err)
+	if err != nil {
+		return err
+	}
 
 	t.t.Log("Uploaded files:\n", uploadResult.UploadedFiles)
 
 	t.uploadResult = uploadResult
 	t.systemBucketName = uploadResult.UploadedFiles[0].Bucket
 
-	t.t.Log("Apply test configmap...")
-	configMapData, err := t.createConfigmapAssetData()
-	failOnError(t.g, err)
-
-	t.t.Log("Preparing metadata...")
 	t.assetDetails = convertToAssetResourceDetails(uploadResult, t.cfg.CommonAssetPrefix)
-	t.assetDetails = append(t.assetDetails, configMapData)
 
+	if len(modes) == 0 || containsMode(modes, v1beta1.AssetConfigMap) {
+		t.t.Log("Apply test configmap...")
+		configMapData, err := t.createConfigmapAssetData()
+		if err != nil {
+			return err
+		}
+		t.assetDetails = append(t.assetDetails, configMapData)
+	}
+
+	if len(modes) > 0 {
+		t.assetDetails = filterAssetDetailsByMode(t.assetDetails, modes)
+	}
+
+	return nil
+}
+
+func containsMode(modes []v1beta1.AssetMode, mode v1beta1.AssetMode) bool {
+	for _, m := range modes {
+		if m == mode {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateAssets creates the spec's Assets and ClusterAssets and waits for them to
+// become ready.
+func (t *TestSuite) CreateAssets() error {
 	t.t.Log("Creating assets...")
-	resourceVersion, err = t.asset.CreateMany(t.assetDetails, t.testId, t.t.Log)
-	failOnError(t.g, err)
+	resourceVersion, err := t.asset.CreateMany(t.assetDetails, t.testId, t.t.Log)
+	if err != nil {
+		return err
+	}
 	t.t.Log("Waiting for assets to have ready phase...")
-	err = t.asset.WaitForStatusesReady(t.assetDetails, resourceVersion, t.t.Log)
-	failOnError(t.g, err)
+	if err := t.asset.WaitForStatusesReady(t.assetDetails, resourceVersion, t.t.Log); err != nil {
+		return err
+	}
 
 	t.t.Log("Creating cluster assets...")
 	resourceVersion, err = t.clusterAsset.CreateMany(t.assetDetails, t.testId, t.t.Log)
-	failOnError(t.g, err)
+	if err != nil {
+		return err
+	}
 	t.t.Log("Waiting for cluster assets to have ready phase...")
-	err = t.clusterAsset.WaitForStatusesReady(t.assetDetails, resourceVersion, t.t.Log)
-	failOnError(t.g, err)
+	return t.clusterAsset.WaitForStatusesReady(t.assetDetails, resourceVersion, t.t.Log)
+}
 
+// PopulateAndVerifyUploads fetches the files Rafter exposed for the spec's assets and
+// verifies they are all reachable (and, if replicas are configured, replicated).
+func (t *TestSuite) PopulateAndVerifyUploads() ([]uploadedFile, error) {
 	t.t.Log(fmt.Sprintf("asset details:\n%v", t.assetDetails))
 	files, err := t.populateUploadedFiles(t.t.Log)
-	failOnError(t.g, err)
+	if err != nil {
+		return nil, err
+	}
 
 	t.t.Log("Verifying uploaded files...")
-	err = t.verifyUploadedFiles(files)
-	failOnError(t.g, err)
+	if err := t.verifyUploadedFiles(files); err != nil {
+		return nil, err
+	}
 
+	return files, nil
+}
+
+// DeleteAssetsAndVerify removes the spec's Assets/ClusterAssets and verifies their
+// backing files disappear from the store.
+func (t *TestSuite) DeleteAssetsAndVerify(files []uploadedFile) error {
 	t.t.Log("Removing assets...")
-	err = t.asset.DeleteLeftovers(t.testId, t.t.Log)
-	failOnError(t.g, err)
+	if err := t.asset.DeleteLeftovers(t.testId, t.t.Log); err != nil {
+		return err
+	}
 
 	t.t.Log("Removing cluster assets...")
-	err = t.clusterAsset.DeleteLeftovers(t.testId, t.t.Log)
-	failOnError(t.g, err)
+	if err := t.clusterAsset.DeleteLeftovers(t.testId, t.t.Log); err != nil {
+		return err
+	}
 
-	err = t.verifyDeletedFiles(files)
-	failOnError(t.g, err)
+	return t.verifyDeletedFiles(files)
 }
 
-func (t *TestSuite) Cleanup() {
+// Teardown removes every resource this spec's TestSuite created, so a failing spec's
+// AfterEach can still run cleanup without depending on earlier steps having succeeded.
+func (t *TestSuite) Teardown() error {
 	t.t.Log("Cleaning up...")
 
-	err := t.configMap.DeleteAll(t.t.Log)
-	failOnError(t.g, err)
+	if err := t.configMap.DeleteAll(t.t.Log); err != nil {
+		return err
+	}
 
-	err = t.clusterBucket.Delete(t.t.Log)
-	failOnError(t.g, err)
+	if err := t.clusterAsset.DeleteLeftovers(t.testId, t.t.Log); err != nil {
+		return err
+	}
 
-	err = t.bucket.Delete(t.t.Log)
-	failOnError(t.g, err)
+	if err := t.clusterBucket.Delete(t.t.Log); err != nil {
+		return err
+	}
 
-	err = t.namespace.Delete(t.t.Log)
-	failOnError(t.g, err)
+	if err := t.bucket.Delete(t.t.Log); err != nil {
+		return err
+	}
 
-	err = deleteFiles(t.minioCli, t.uploadResult, t.t.Logf)
-	failOnError(t.g, err)
+	if err := t.namespace.Delete(t.t.Log); err != nil {
+		return err
+	}
+
+	return deleteFiles(t.minioCli, t.uploadResult, t.t.Logf)
 }
 
 func (t *TestSuite) uploadTestFiles() (*upload.Response, error) {
@@ -280,6 +370,11 @@ func (t *TestSuite) verifyUploadedFiles(files []uploadedFile) error {
 	if err != nil {
 		return errors.Wrap(err, "while verifying uploaded files")
 	}
+
+	if err := t.verifyReplicatedFiles(files, true); err != nil {
+		return errors.Wrap(err, "while verifying replicated files")
+	}
+
 	return nil
 }
 
@@ -288,9 +383,10 @@ func (t *TestSuite) verifyDeletedFiles(files []uploadedFile) error {
 	if err != nil {
 		return errors.Wrap(err, "while verifying deleted files")
 	}
-	return nil
-}
 
-func failOnError(g *gomega.GomegaWithT, err error) {
-	g.Expect(err).NotTo(gomega.HaveOccurred())
+	if err := t.verifyReplicatedFiles(files, false); err != nil {
+		return errors.Wrap(err, "while verifying replicated file deletion")
+	}
+
+	return nil
 }
@@ -0,0 +1,37 @@
+package testsuite
+
+import (
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var assetGVR = schema.GroupVersionResource{Group: "rafter.kyma-project.io", Version: "v1beta1", Resource: "assets"}
+
+// verifyRejectedManifests asserts the validating webhook rejects an Asset with an
+// unknown Mode before any controller ever sees it, instead of letting it reach the
+// cluster and fail at reconcile time.
+func (t *TestSuite) verifyRejectedManifests() error {
+	invalidAsset := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "rafter.kyma-project.io/v1beta1",
+			"kind":       "Asset",
+			"metadata": map[string]interface{}{
+				"name":      "invalid-mode-asset",
+				"namespace": t.cfg.Namespace,
+			},
+			"spec": map[string]interface{}{
+				"source": map[string]interface{}{
+					"url":  "https://example.com/foo.zip",
+					"mode": "bogus",
+				},
+			},
+		},
+	}
+
+	_, err := t.dynamicCli.Resource(assetGVR).Namespace(t.cfg.Namespace).Create(invalidAsset, metav1.CreateOptions{})
+	t.g.Expect(err).To(gomega.HaveOccurred(), "expected the validating webhook to reject an Asset with an unknown mode")
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package testsuite
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Webhook validation", func() {
+	var ts *TestSuite
+
+	BeforeEach(func() {
+		var err error
+		ts, _, err = newSpecSuite()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(ts.DeleteLeftovers()).To(Succeed())
+		Expect(ts.SetupNamespace()).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(ts.Teardown()).To(Succeed())
+	})
+
+	It("rejects an Asset with an unknown mode before any controller runs", func() {
+		Expect(ts.verifyRejectedManifests()).To(Succeed())
+	})
+})
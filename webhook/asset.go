@@ -0,0 +1,58 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kyma-project/rafter/pkg/apis/rafter/v1beta1"
+)
+
+// AssetValidator rejects Asset creates/updates with a source the controller could
+// never reconcile, instead of letting the asset sit in a Failed phase.
+type AssetValidator struct {
+	decoder *admission.Decoder
+}
+
+// NewAssetValidator returns an AssetValidator bound to scheme.
+func NewAssetValidator(scheme *runtime.Scheme) *AssetValidator {
+	return &AssetValidator{decoder: admission.NewDecoder(scheme)}
+}
+
+func (v *AssetValidator) Handle(_ context.Context, req admission.Request) admission.Response {
+	asset := &v1beta1.Asset{}
+	if err := v.decoder.Decode(req, asset); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if err := validateAssetSource(asset.Spec.Source); err != nil {
+		return admission.Denied(err.Error())
+	}
+
+	return admission.Allowed("")
+}
+
+// ClusterAssetValidator is the cluster-scoped counterpart of AssetValidator.
+type ClusterAssetValidator struct {
+	decoder *admission.Decoder
+}
+
+// NewClusterAssetValidator returns a ClusterAssetValidator bound to scheme.
+func NewClusterAssetValidator(scheme *runtime.Scheme) *ClusterAssetValidator {
+	return &ClusterAssetValidator{decoder: admission.NewDecoder(scheme)}
+}
+
+func (v *ClusterAssetValidator) Handle(_ context.Context, req admission.Request) admission.Response {
+	clusterAsset := &v1beta1.ClusterAsset{}
+	if err := v.decoder.Decode(req, clusterAsset); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if err := validateAssetSource(clusterAsset.Spec.Source); err != nil {
+		return admission.Denied(err.Error())
+	}
+
+	return admission.Allowed("")
+}
@@ -0,0 +1,62 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kyma-project/rafter/pkg/apis/rafter/v1beta1"
+)
+
+// BucketValidator rejects Bucket creates/updates that request a region the configured
+// MinIO instance does not support.
+type BucketValidator struct {
+	decoder          *admission.Decoder
+	supportedRegions []string
+}
+
+// NewBucketValidator returns a BucketValidator bound to scheme, rejecting any region not
+// present in supportedRegions (an empty list disables the region check).
+func NewBucketValidator(scheme *runtime.Scheme, supportedRegions []string) *BucketValidator {
+	return &BucketValidator{decoder: admission.NewDecoder(scheme), supportedRegions: supportedRegions}
+}
+
+func (v *BucketValidator) Handle(_ context.Context, req admission.Request) admission.Response {
+	bucket := &v1beta1.Bucket{}
+	if err := v.decoder.Decode(req, bucket); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if err := validateBucketRegion(bucket.Spec.Region, v.supportedRegions); err != nil {
+		return admission.Denied(err.Error())
+	}
+
+	return admission.Allowed("")
+}
+
+// ClusterBucketValidator is the cluster-scoped counterpart of BucketValidator.
+type ClusterBucketValidator struct {
+	decoder          *admission.Decoder
+	supportedRegions []string
+}
+
+// NewClusterBucketValidator returns a ClusterBucketValidator bound to scheme, rejecting
+// any region not present in supportedRegions (an empty list disables the region check).
+func NewClusterBucketValidator(scheme *runtime.Scheme, supportedRegions []string) *ClusterBucketValidator {
+	return &ClusterBucketValidator{decoder: admission.NewDecoder(scheme), supportedRegions: supportedRegions}
+}
+
+func (v *ClusterBucketValidator) Handle(_ context.Context, req admission.Request) admission.Response {
+	clusterBucket := &v1beta1.ClusterBucket{}
+	if err := v.decoder.Decode(req, clusterBucket); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if err := validateBucketRegion(clusterBucket.Spec.Region, v.supportedRegions); err != nil {
+		return admission.Denied(err.Error())
+	}
+
+	return admission.Allowed("")
+}
@@ -0,0 +1,127 @@
+package webhook
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const certValidity = 10 * 365 * 24 * time.Hour
+
+// ensureServingCerts makes sure certDir holds a tls.crt/tls.key pair valid for dnsNames,
+// generating a self-signed CA and leaf certificate on first run (following the same
+// pattern used by the cluster-stack-operator webhook addition, which also has no
+// cert-manager dependency to rely on). Later calls reuse whatever is already on disk, so
+// a pod restart doesn't rotate the certificate out from under a caBundle that was
+// already patched into the webhook configurations.
+func ensureServingCerts(certDir string, dnsNames []string) error {
+	certPath := filepath.Join(certDir, "tls.crt")
+	keyPath := filepath.Join(certDir, "tls.key")
+
+	if _, err := os.Stat(certPath); err == nil {
+		if _, err := os.Stat(keyPath); err == nil {
+			return nil
+		}
+	}
+
+	if err := os.MkdirAll(certDir, 0700); err != nil {
+		return errors.Wrapf(err, "while creating cert dir %s", certDir)
+	}
+
+	caKey, caCert, caDER, err := generateSelfSignedCA()
+	if err != nil {
+		return err
+	}
+
+	leafKey, leafDER, err := generateServingCert(dnsNames, caCert, caKey)
+	if err != nil {
+		return err
+	}
+
+	if err := writePEM(certPath, "CERTIFICATE", leafDER); err != nil {
+		return err
+	}
+	if err := writePEMKey(keyPath, leafKey); err != nil {
+		return err
+	}
+	return writePEM(filepath.Join(certDir, "ca.crt"), "CERTIFICATE", caDER)
+}
+
+func generateSelfSignedCA() (*rsa.PrivateKey, *x509.Certificate, []byte, error) {
+	caKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "while generating CA key")
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "rafter-webhook-ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(certValidity),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &caKey.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "while self-signing CA certificate")
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "while parsing CA certificate")
+	}
+
+	return caKey, cert, der, nil
+}
+
+func generateServingCert(dnsNames []string, caCert *x509.Certificate, caKey *rsa.PrivateKey) (*rsa.PrivateKey, []byte, error) {
+	leafKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "while generating serving key")
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: dnsNames[0]},
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(certValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, caCert, &leafKey.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "while signing serving certificate")
+	}
+
+	return leafKey, der, nil
+}
+
+func writePEM(path, blockType string, der []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "while creating %s", path)
+	}
+	defer f.Close()
+	return pem.Encode(f, &pem.Block{Type: blockType, Bytes: der})
+}
+
+func writePEMKey(path string, key *rsa.PrivateKey) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "while creating %s", path)
+	}
+	defer f.Close()
+	return pem.Encode(f, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+}
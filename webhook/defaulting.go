@@ -0,0 +1,129 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kyma-project/rafter/pkg/apis/rafter/v1beta1"
+)
+
+// AssetDefaulter defaults Asset fields the controller would otherwise have to guess at,
+// so an Asset created without Source.Mode is not rejected outright by AssetValidator.
+type AssetDefaulter struct {
+	decoder *admission.Decoder
+}
+
+// NewAssetDefaulter returns an AssetDefaulter bound to scheme.
+func NewAssetDefaulter(scheme *runtime.Scheme) *AssetDefaulter {
+	return &AssetDefaulter{decoder: admission.NewDecoder(scheme)}
+}
+
+func (d *AssetDefaulter) Handle(_ context.Context, req admission.Request) admission.Response {
+	asset := &v1beta1.Asset{}
+	if err := d.decoder.Decode(req, asset); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	defaultAssetSource(&asset.Spec.Source)
+
+	marshaled, err := json.Marshal(asset)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaled)
+}
+
+// ClusterAssetDefaulter is the cluster-scoped counterpart of AssetDefaulter.
+type ClusterAssetDefaulter struct {
+	decoder *admission.Decoder
+}
+
+// NewClusterAssetDefaulter returns a ClusterAssetDefaulter bound to scheme.
+func NewClusterAssetDefaulter(scheme *runtime.Scheme) *ClusterAssetDefaulter {
+	return &ClusterAssetDefaulter{decoder: admission.NewDecoder(scheme)}
+}
+
+func (d *ClusterAssetDefaulter) Handle(_ context.Context, req admission.Request) admission.Response {
+	clusterAsset := &v1beta1.ClusterAsset{}
+	if err := d.decoder.Decode(req, clusterAsset); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	defaultAssetSource(&clusterAsset.Spec.Source)
+
+	marshaled, err := json.Marshal(clusterAsset)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaled)
+}
+
+// defaultAssetSource fills in Source fields a user is allowed to leave unset.
+func defaultAssetSource(source *v1beta1.AssetSource) {
+	if source.Mode == "" {
+		source.Mode = v1beta1.AssetSingle
+	}
+}
+
+// BucketDefaulter defaults Bucket fields the controller would otherwise have to guess
+// at.
+type BucketDefaulter struct {
+	decoder *admission.Decoder
+}
+
+// NewBucketDefaulter returns a BucketDefaulter bound to scheme.
+func NewBucketDefaulter(scheme *runtime.Scheme) *BucketDefaulter {
+	return &BucketDefaulter{decoder: admission.NewDecoder(scheme)}
+}
+
+func (d *BucketDefaulter) Handle(_ context.Context, req admission.Request) admission.Response {
+	bucket := &v1beta1.Bucket{}
+	if err := d.decoder.Decode(req, bucket); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	defaultBucketPolicy(&bucket.Spec.Policy)
+
+	marshaled, err := json.Marshal(bucket)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaled)
+}
+
+// ClusterBucketDefaulter is the cluster-scoped counterpart of BucketDefaulter.
+type ClusterBucketDefaulter struct {
+	decoder *admission.Decoder
+}
+
+// NewClusterBucketDefaulter returns a ClusterBucketDefaulter bound to scheme.
+func NewClusterBucketDefaulter(scheme *runtime.Scheme) *ClusterBucketDefaulter {
+	return &ClusterBucketDefaulter{decoder: admission.NewDecoder(scheme)}
+}
+
+func (d *ClusterBucketDefaulter) Handle(_ context.Context, req admission.Request) admission.Response {
+	clusterBucket := &v1beta1.ClusterBucket{}
+	if err := d.decoder.Decode(req, clusterBucket); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	defaultBucketPolicy(&clusterBucket.Spec.Policy)
+
+	marshaled, err := json.Marshal(clusterBucket)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaled)
+}
+
+// defaultBucketPolicy fills in Policy when a user leaves it unset, rather than letting
+// downstream consumers treat the empty string as a distinct, undocumented policy.
+func defaultBucketPolicy(policy *v1beta1.BucketPolicy) {
+	if *policy == "" {
+		*policy = v1beta1.BucketPolicyNone
+	}
+}
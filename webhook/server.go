@@ -0,0 +1,79 @@
+// Package webhook wires validating and defaulting admission webhooks for the rafter
+// CRDs (Asset, ClusterAsset, Bucket, ClusterBucket) into a controller-runtime manager,
+// following the same pattern used by the cluster-stack-operator webhook server.
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// Config holds the settings needed to stand up the admission webhook server.
+type Config struct {
+	Port    int    `envconfig:"default=9443"`
+	CertDir string `envconfig:"optional"`
+	// ServiceName and ServiceNamespace identify the Service fronting this webhook
+	// server. They are used as the DNS SANs on the generated serving certificate, so
+	// leave them unset only when CertDir already holds a certificate provisioned some
+	// other way (e.g. by cert-manager).
+	ServiceName      string `envconfig:"optional"`
+	ServiceNamespace string `envconfig:"optional"`
+}
+
+// AddToManager registers every admission webhook with mgr's webhook server.
+func AddToManager(mgr ctrl.Manager, cfg Config, supportedRegions []string) error {
+	server := mgr.GetWebhookServer()
+	server.Port = cfg.Port
+
+	certDir := cfg.CertDir
+	if certDir == "" {
+		certDir = filepath.Join(os.TempDir(), "rafter-webhook-certs")
+	}
+	server.CertDir = certDir
+
+	if cfg.ServiceName != "" && cfg.ServiceNamespace != "" {
+		if err := ensureServingCerts(certDir, serviceDNSNames(cfg.ServiceName, cfg.ServiceNamespace)); err != nil {
+			return errors.Wrap(err, "while provisioning webhook serving certificate")
+		}
+
+		caBundle, err := ioutil.ReadFile(filepath.Join(certDir, "ca.crt"))
+		if err != nil {
+			return errors.Wrap(err, "while reading the generated CA certificate")
+		}
+
+		// The webhook configurations have to be created/patched with this CA before the
+		// server starts serving, or the API server will never route admission requests
+		// to it in the meantime.
+		if err := ensureWebhookConfigurations(context.Background(), mgr.GetClient(), caBundle, cfg.ServiceName, cfg.ServiceNamespace); err != nil {
+			return errors.Wrap(err, "while registering webhook configurations")
+		}
+	}
+
+	server.Register("/validate-rafter-kyma-project-io-v1beta1-asset", &webhook.Admission{Handler: NewAssetValidator(mgr.GetScheme())})
+	server.Register("/validate-rafter-kyma-project-io-v1beta1-clusterasset", &webhook.Admission{Handler: NewClusterAssetValidator(mgr.GetScheme())})
+	server.Register("/validate-rafter-kyma-project-io-v1beta1-bucket", &webhook.Admission{Handler: NewBucketValidator(mgr.GetScheme(), supportedRegions)})
+	server.Register("/validate-rafter-kyma-project-io-v1beta1-clusterbucket", &webhook.Admission{Handler: NewClusterBucketValidator(mgr.GetScheme(), supportedRegions)})
+
+	server.Register("/mutate-rafter-kyma-project-io-v1beta1-asset", &webhook.Admission{Handler: NewAssetDefaulter(mgr.GetScheme())})
+	server.Register("/mutate-rafter-kyma-project-io-v1beta1-clusterasset", &webhook.Admission{Handler: NewClusterAssetDefaulter(mgr.GetScheme())})
+	server.Register("/mutate-rafter-kyma-project-io-v1beta1-bucket", &webhook.Admission{Handler: NewBucketDefaulter(mgr.GetScheme())})
+	server.Register("/mutate-rafter-kyma-project-io-v1beta1-clusterbucket", &webhook.Admission{Handler: NewClusterBucketDefaulter(mgr.GetScheme())})
+
+	return nil
+}
+
+// serviceDNSNames returns the in-cluster DNS names a Service is reachable under, which
+// is what the webhook configurations' clientConfig.service resolves against.
+func serviceDNSNames(name, namespace string) []string {
+	return []string{
+		fmt.Sprintf("%s.%s.svc", name, namespace),
+		fmt.Sprintf("%s.%s.svc.cluster.local", name, namespace),
+	}
+}
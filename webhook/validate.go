@@ -0,0 +1,77 @@
+package webhook
+
+import (
+	"fmt"
+
+	"github.com/kyma-project/rafter/pkg/apis/rafter/v1beta1"
+)
+
+var supportedAssetModes = map[v1beta1.AssetMode]bool{
+	v1beta1.AssetSingle:    true,
+	v1beta1.AssetPackage:   true,
+	v1beta1.AssetIndex:     true,
+	v1beta1.AssetConfigMap: true,
+}
+
+// validateAssetSource rejects sources the controllers could never reconcile, so they
+// never reach a namespace in an error state.
+func validateAssetSource(source v1beta1.AssetSource) error {
+	if source.URL == "" {
+		return fmt.Errorf("spec.source.url must not be empty")
+	}
+
+	if !supportedAssetModes[source.Mode] {
+		return fmt.Errorf("spec.source.mode %q is not a supported asset mode", source.Mode)
+	}
+
+	if source.Filter != "" && len(source.MetadataWebhookService) > 0 {
+		return fmt.Errorf("spec.source.filter cannot be combined with spec.source.metadataWebhookService")
+	}
+
+	for _, svc := range source.ValidationWebhookService {
+		if err := validateWebhookService(svc); err != nil {
+			return fmt.Errorf("spec.source.validationWebhookService: %v", err)
+		}
+	}
+	for _, svc := range source.MetadataWebhookService {
+		if err := validateWebhookService(svc); err != nil {
+			return fmt.Errorf("spec.source.metadataWebhookService: %v", err)
+		}
+	}
+	for _, svc := range source.MutationWebhookService {
+		if err := validateWebhookService(v1beta1.AssetWebhookService(svc)); err != nil {
+			return fmt.Errorf("spec.source.mutationWebhookService: %v", err)
+		}
+	}
+
+	return nil
+}
+
+func validateWebhookService(svc v1beta1.AssetWebhookService) error {
+	if svc.Name == "" {
+		return fmt.Errorf("name must not be empty")
+	}
+	if svc.Namespace == "" {
+		return fmt.Errorf("namespace must not be empty")
+	}
+	if svc.Endpoint == "" {
+		return fmt.Errorf("endpoint must not be empty")
+	}
+	return nil
+}
+
+// validateBucketRegion rejects a region that the configured MinIO instance does not
+// serve, rather than letting the bucket controller fail at reconcile time.
+func validateBucketRegion(region string, supportedRegions []string) error {
+	if region == "" || len(supportedRegions) == 0 {
+		return nil
+	}
+
+	for _, supported := range supportedRegions {
+		if region == supported {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("spec.region %q is not among the regions supported by the configured MinIO instance: %v", region, supportedRegions)
+}
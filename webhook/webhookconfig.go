@@ -0,0 +1,148 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	validatingWebhookConfigName = "rafter-validating-webhook-configuration"
+	mutatingWebhookConfigName   = "rafter-mutating-webhook-configuration"
+
+	apiGroup   = "rafter.kyma-project.io"
+	apiVersion = "v1beta1"
+)
+
+// webhookResource is one CRD that AddToManager registers a validating and a defaulting
+// handler for, matching the URL paths already registered on the webhook server.
+type webhookResource struct {
+	kind     string
+	resource string
+}
+
+var webhookResources = []webhookResource{
+	{kind: "asset", resource: "assets"},
+	{kind: "clusterasset", resource: "clusterassets"},
+	{kind: "bucket", resource: "buckets"},
+	{kind: "clusterbucket", resource: "clusterbuckets"},
+}
+
+// ensureWebhookConfigurations creates or updates the ValidatingWebhookConfiguration and
+// MutatingWebhookConfiguration that make the API server route admission requests for the
+// rafter CRDs to this server's registered handlers, with clientConfig.caBundle set to
+// caBundle so the API server trusts the serving certificate ensureServingCerts generated.
+// Registering the handlers with server.Register alone does not do this - without a
+// matching webhook configuration, the API server never calls them.
+func ensureWebhookConfigurations(ctx context.Context, cli client.Client, caBundle []byte, serviceName, serviceNamespace string) error {
+	if err := ensureValidatingWebhookConfiguration(ctx, cli, caBundle, serviceName, serviceNamespace); err != nil {
+		return err
+	}
+	return ensureMutatingWebhookConfiguration(ctx, cli, caBundle, serviceName, serviceNamespace)
+}
+
+func ensureValidatingWebhookConfiguration(ctx context.Context, cli client.Client, caBundle []byte, serviceName, serviceNamespace string) error {
+	webhooks := make([]admissionregistrationv1.ValidatingWebhook, 0, len(webhookResources))
+	for _, res := range webhookResources {
+		webhooks = append(webhooks, validatingWebhookFor(res, caBundle, serviceName, serviceNamespace))
+	}
+
+	existing := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+	err := cli.Get(ctx, client.ObjectKey{Name: validatingWebhookConfigName}, existing)
+	if apierrors.IsNotFound(err) {
+		existing = &admissionregistrationv1.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: validatingWebhookConfigName},
+			Webhooks:   webhooks,
+		}
+		return errors.Wrap(cli.Create(ctx, existing), "while creating the validating webhook configuration")
+	}
+	if err != nil {
+		return errors.Wrap(err, "while fetching the validating webhook configuration")
+	}
+
+	existing.Webhooks = webhooks
+	return errors.Wrap(cli.Update(ctx, existing), "while updating the validating webhook configuration")
+}
+
+func ensureMutatingWebhookConfiguration(ctx context.Context, cli client.Client, caBundle []byte, serviceName, serviceNamespace string) error {
+	webhooks := make([]admissionregistrationv1.MutatingWebhook, 0, len(webhookResources))
+	for _, res := range webhookResources {
+		webhooks = append(webhooks, mutatingWebhookFor(res, caBundle, serviceName, serviceNamespace))
+	}
+
+	existing := &admissionregistrationv1.MutatingWebhookConfiguration{}
+	err := cli.Get(ctx, client.ObjectKey{Name: mutatingWebhookConfigName}, existing)
+	if apierrors.IsNotFound(err) {
+		existing = &admissionregistrationv1.MutatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: mutatingWebhookConfigName},
+			Webhooks:   webhooks,
+		}
+		return errors.Wrap(cli.Create(ctx, existing), "while creating the mutating webhook configuration")
+	}
+	if err != nil {
+		return errors.Wrap(err, "while fetching the mutating webhook configuration")
+	}
+
+	existing.Webhooks = webhooks
+	return errors.Wrap(cli.Update(ctx, existing), "while updating the mutating webhook configuration")
+}
+
+func validatingWebhookFor(res webhookResource, caBundle []byte, serviceName, serviceNamespace string) admissionregistrationv1.ValidatingWebhook {
+	path := fmt.Sprintf("/validate-rafter-kyma-project-io-v1beta1-%s", res.kind)
+	failurePolicy := admissionregistrationv1.Fail
+	sideEffects := admissionregistrationv1.SideEffectClassNone
+
+	return admissionregistrationv1.ValidatingWebhook{
+		Name:                    fmt.Sprintf("validate-%s.%s", res.kind, apiGroup),
+		AdmissionReviewVersions: []string{"v1"},
+		FailurePolicy:           &failurePolicy,
+		SideEffects:             &sideEffects,
+		ClientConfig:            clientConfigFor(path, caBundle, serviceName, serviceNamespace),
+		Rules:                   rulesFor(res),
+	}
+}
+
+func mutatingWebhookFor(res webhookResource, caBundle []byte, serviceName, serviceNamespace string) admissionregistrationv1.MutatingWebhook {
+	path := fmt.Sprintf("/mutate-rafter-kyma-project-io-v1beta1-%s", res.kind)
+	failurePolicy := admissionregistrationv1.Fail
+	sideEffects := admissionregistrationv1.SideEffectClassNone
+
+	return admissionregistrationv1.MutatingWebhook{
+		Name:                    fmt.Sprintf("mutate-%s.%s", res.kind, apiGroup),
+		AdmissionReviewVersions: []string{"v1"},
+		FailurePolicy:           &failurePolicy,
+		SideEffects:             &sideEffects,
+		ClientConfig:            clientConfigFor(path, caBundle, serviceName, serviceNamespace),
+		Rules:                   rulesFor(res),
+	}
+}
+
+func clientConfigFor(path string, caBundle []byte, serviceName, serviceNamespace string) admissionregistrationv1.WebhookClientConfig {
+	return admissionregistrationv1.WebhookClientConfig{
+		CABundle: caBundle,
+		Service: &admissionregistrationv1.ServiceReference{
+			Name:      serviceName,
+			Namespace: serviceNamespace,
+			Path:      &path,
+		},
+	}
+}
+
+func rulesFor(res webhookResource) []admissionregistrationv1.RuleWithOperations {
+	return []admissionregistrationv1.RuleWithOperations{
+		{
+			Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update},
+			Rule: admissionregistrationv1.Rule{
+				APIGroups:   []string{apiGroup},
+				APIVersions: []string{apiVersion},
+				Resources:   []string{res.resource},
+			},
+		},
+	}
+}